@@ -17,56 +17,174 @@ import (
 type Provider interface {
 	GetClient() *ethclient.Client
 	GetBalance(address common.Address, blockNumber BlockNumber) (*big.Int, error)
+	GetBalanceContext(ctx context.Context, address common.Address, blockNumber BlockNumber) (*big.Int, error)
 	GetBlockByNumber(blockNumber BlockNumber) (*Block, error)
+	GetBlockByNumberContext(ctx context.Context, blockNumber BlockNumber) (*Block, error)
 	GetBlockByHash(blockHash common.Hash) (*Block, error)
+	GetBlockByHashContext(ctx context.Context, blockHash common.Hash) (*Block, error)
 	GetTransactionCount(address common.Address, blockNumber BlockNumber) (*big.Int, error)
+	GetTransactionCountContext(ctx context.Context, address common.Address, blockNumber BlockNumber) (*big.Int, error)
 	GetTransactionReceipt(txHash common.Hash) (*TransactionReceipt, error)
+	GetTransactionReceiptContext(ctx context.Context, txHash common.Hash) (*TransactionReceipt, error)
 	GetTransaction(txHash common.Hash) (*TransactionResponse, error)
+	GetTransactionContext(ctx context.Context, txHash common.Hash) (*TransactionResponse, error)
 	WaitMined(ctx context.Context, txHash common.Hash) (*TransactionReceipt, error)
 	WaitFinalized(ctx context.Context, txHash common.Hash) (*TransactionReceipt, error)
 	EstimateGas(tx *Transaction) (*big.Int, error)
+	EstimateGasContext(ctx context.Context, tx *Transaction) (*big.Int, error)
 	GetGasPrice() (*big.Int, error)
+	GetGasPriceContext(ctx context.Context) (*big.Int, error)
 	SendRawTransaction(tx []byte) (common.Hash, error)
+	SendRawTransactionContext(ctx context.Context, tx []byte) (common.Hash, error)
 	ZksGetMainContract() (common.Address, error)
+	ZksGetMainContractContext(ctx context.Context) (common.Address, error)
 	ZksL1ChainId() (*big.Int, error)
+	ZksL1ChainIdContext(ctx context.Context) (*big.Int, error)
 	ZksL1BatchNumber() (*big.Int, error)
+	ZksL1BatchNumberContext(ctx context.Context) (*big.Int, error)
 	ZksGetConfirmedTokens(from uint32, limit uint8) ([]*Token, error)
+	ZksGetConfirmedTokensContext(ctx context.Context, from uint32, limit uint8) ([]*Token, error)
 	ZksIsTokenLiquid(address common.Address) (bool, error)
+	ZksIsTokenLiquidContext(ctx context.Context, address common.Address) (bool, error)
 	ZksGetTokenPrice(address common.Address) (*big.Float, error)
+	ZksGetTokenPriceContext(ctx context.Context, address common.Address) (*big.Float, error)
 	ZksGetL2ToL1LogProof(txHash common.Hash, logIndex int) (*L2ToL1MessageProof, error)
+	ZksGetL2ToL1LogProofContext(ctx context.Context, txHash common.Hash, logIndex int) (*L2ToL1MessageProof, error)
 	ZksGetL2ToL1MsgProof(block uint32, sender common.Address, msg common.Hash) (*L2ToL1MessageProof, error)
+	ZksGetL2ToL1MsgProofContext(ctx context.Context, block uint32, sender common.Address, msg common.Hash) (*L2ToL1MessageProof, error)
 	ZksGetAllAccountBalances(address common.Address) (map[common.Address]*big.Int, error)
+	ZksGetAllAccountBalancesContext(ctx context.Context, address common.Address) (map[common.Address]*big.Int, error)
 	ZksGetBridgeContracts() (*BridgeContracts, error)
+	ZksGetBridgeContractsContext(ctx context.Context) (*BridgeContracts, error)
 	ZksEstimateFee(tx *Transaction) (*Fee, error)
+	ZksEstimateFeeContext(ctx context.Context, tx *Transaction) (*Fee, error)
 	ZksGetTestnetPaymaster() (common.Address, error)
+	ZksGetTestnetPaymasterContext(ctx context.Context) (common.Address, error)
 	ZksGetBlockDetails(block uint32) (*BlockDetails, error)
+	ZksGetBlockDetailsContext(ctx context.Context, block uint32) (*BlockDetails, error)
 	GetLogs(q FilterQuery) ([]Log, error)
+	GetLogsContext(ctx context.Context, q FilterQuery) ([]Log, error)
+	FeeHistory(blockCount uint64, newestBlock BlockNumber, rewardPercentiles []float64) (*FeeHistoryResult, error)
+	FeeHistoryContext(ctx context.Context, blockCount uint64, newestBlock BlockNumber, rewardPercentiles []float64) (*FeeHistoryResult, error)
+	Call(tx *Transaction, blockNumber BlockNumber) ([]byte, error)
+	CallContext(ctx context.Context, tx *Transaction, blockNumber BlockNumber) ([]byte, error)
+	TraceCall(tx *Transaction, blockNumber BlockNumber, config *TraceConfig) (*TraceResult, error)
+	TraceCallContext(ctx context.Context, tx *Transaction, blockNumber BlockNumber, config *TraceConfig) (*TraceResult, error)
+	TraceTransaction(txHash common.Hash, config *TraceConfig) (*TraceResult, error)
+	TraceTransactionContext(ctx context.Context, txHash common.Hash, config *TraceConfig) (*TraceResult, error)
+	TraceBlockByNumber(blockNumber BlockNumber, config *TraceConfig) ([]*TraceResult, error)
+	TraceBlockByNumberContext(ctx context.Context, blockNumber BlockNumber, config *TraceConfig) ([]*TraceResult, error)
+	ResendTransaction(w *Wallet, txHash common.Hash, newGasPrice, newGasLimit *big.Int) (common.Hash, error)
+	ResendTransactionContext(ctx context.Context, w *Wallet, txHash common.Hash, newGasPrice, newGasLimit *big.Int) (common.Hash, error)
+	GetPendingTransactions(address common.Address) ([]*TransactionResponse, error)
+	GetPendingTransactionsContext(ctx context.Context, address common.Address) ([]*TransactionResponse, error)
+	Batch() *Batch
+	SubscribeNewHeads(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	SubscribeLogs(ctx context.Context, q FilterQuery, ch chan<- Log) (ethereum.Subscription, error)
+	SubscribeNewPendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error)
+	SubscribeL1BatchCommits(ctx context.Context, ch chan<- *L1BatchCommit) (ethereum.Subscription, error)
+	SubscribeL2ToL1Messages(ctx context.Context, ch chan<- *L2ToL1Message) (ethereum.Subscription, error)
 }
 
-func NewDefaultProvider(rawUrl string) (*DefaultProvider, error) {
+// NewDefaultProvider dials rawUrl and wires it up as the primary endpoint.
+// By default calls go straight to that endpoint; pass ProviderOptions to
+// layer retrying, metrics, or endpoint failover on top via a Transport.
+func NewDefaultProvider(rawUrl string, opts ...ProviderOption) (*DefaultProvider, error) {
+	cfg := &providerConfig{}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, fmt.Errorf("invalid provider option: %w", err)
+		}
+	}
+
 	rpcClient, err := rpc.Dial(rawUrl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to rpc.Dial(): %w", err)
 	}
+
+	var transport Transport = rpcClient
+	if len(cfg.endpoints) > 0 {
+		clients := []Transport{rpcClient}
+		for _, endpoint := range cfg.endpoints {
+			extra, err := rpc.Dial(endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("failed to rpc.Dial() endpoint %s: %w", endpoint, err)
+			}
+			clients = append(clients, extra)
+		}
+		transport = newRoundRobinTransport(clients)
+	}
+	if cfg.fallback != "" {
+		fallbackClient, err := rpc.Dial(cfg.fallback)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rpc.Dial() fallback endpoint: %w", err)
+		}
+		transport = newFallbackTransport(transport, fallbackClient)
+	}
+	if cfg.retry != nil {
+		transport = newRetryTransport(transport, *cfg.retry)
+	}
+	if cfg.registerer != nil {
+		transport = newMetricsTransport(transport, cfg.registerer)
+	}
+
 	return &DefaultProvider{
-		c:      rpcClient,
-		Client: ethclient.NewClient(rpcClient),
+		rawUrl:    rawUrl,
+		rpcClient: rpcClient,
+		c:         transport,
+		Client:    ethclient.NewClient(rpcClient),
 	}, nil
 }
 
+// NewDefaultProviderWithDebugEndpoint behaves like NewDefaultProvider but
+// routes TraceCall/TraceTransaction/TraceBlockByNumber to a secondary RPC
+// endpoint, for nodes that don't expose the debug namespace on their main
+// endpoint.
+func NewDefaultProviderWithDebugEndpoint(rawUrl, debugUrl string, opts ...ProviderOption) (*DefaultProvider, error) {
+	p, err := NewDefaultProvider(rawUrl, opts...)
+	if err != nil {
+		return nil, err
+	}
+	debugClient, err := rpc.Dial(debugUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rpc.Dial() debug endpoint: %w", err)
+	}
+	p.debugClient = debugClient
+	return p, nil
+}
+
 type DefaultProvider struct {
-	c *rpc.Client
+	rawUrl string
+	// rpcClient is the primary endpoint's raw client, used where the full
+	// *rpc.Client API is needed (subscriptions, batches) rather than just
+	// Transport's Call/CallContext.
+	rpcClient *rpc.Client
+	c         Transport
+	// debugClient is used for debug/trace calls when set, falling back to c otherwise
+	debugClient *rpc.Client
 	// also inherit default Ethereum client
 	*ethclient.Client
 }
 
+// debugRPC returns the transport debug/trace calls should be routed through.
+func (p *DefaultProvider) debugRPC() Transport {
+	if p.debugClient != nil {
+		return p.debugClient
+	}
+	return p.c
+}
+
 func (p *DefaultProvider) GetClient() *ethclient.Client {
 	return p.Client
 }
 
 func (p *DefaultProvider) GetBalance(address common.Address, blockNumber BlockNumber) (*big.Int, error) {
+	return p.GetBalanceContext(context.Background(), address, blockNumber)
+}
+
+func (p *DefaultProvider) GetBalanceContext(ctx context.Context, address common.Address, blockNumber BlockNumber) (*big.Int, error) {
 	var res string
-	err := p.c.Call(&res, "eth_getBalance", address, blockNumber)
+	err := p.c.CallContext(ctx, &res, "eth_getBalance", address, blockNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_getBalance: %w", err)
 	}
@@ -78,19 +196,23 @@ func (p *DefaultProvider) GetBalance(address common.Address, blockNumber BlockNu
 }
 
 func (p *DefaultProvider) GetBlockByNumber(blockNumber BlockNumber) (*Block, error) {
+	return p.GetBlockByNumberContext(context.Background(), blockNumber)
+}
+
+func (p *DefaultProvider) GetBlockByNumberContext(ctx context.Context, blockNumber BlockNumber) (*Block, error) {
 	type TmpBlock struct {
 		Number           hexutil.Big  `json:"number"`
 		L1BatchNumber    *hexutil.Big `json:"l1BatchNumber"`
 		L1BatchTimestamp *hexutil.Big `json:"l1BatchTimestamp"`
 	}
 	var resp *TmpBlock
-	err := p.c.Call(&resp, "eth_getBlockByNumber", blockNumber, false)
+	err := p.c.CallContext(ctx, &resp, "eth_getBlockByNumber", blockNumber, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_getBlockByNumber: %w", err)
 	} else if resp == nil {
 		return nil, ethereum.NotFound
 	}
-	ethBlock, err := p.Client.BlockByNumber(context.Background(), resp.Number.ToInt())
+	ethBlock, err := p.Client.BlockByNumber(ctx, resp.Number.ToInt())
 	if err != nil {
 		return nil, err
 	}
@@ -102,18 +224,22 @@ func (p *DefaultProvider) GetBlockByNumber(blockNumber BlockNumber) (*Block, err
 }
 
 func (p *DefaultProvider) GetBlockByHash(blockHash common.Hash) (*Block, error) {
+	return p.GetBlockByHashContext(context.Background(), blockHash)
+}
+
+func (p *DefaultProvider) GetBlockByHashContext(ctx context.Context, blockHash common.Hash) (*Block, error) {
 	type TmpBlock struct {
 		L1BatchNumber    *hexutil.Big `json:"l1BatchNumber"`
 		L1BatchTimestamp *hexutil.Big `json:"l1BatchTimestamp"`
 	}
 	var resp *TmpBlock
-	err := p.c.Call(&resp, "eth_getBlockByHash", blockHash, false)
+	err := p.c.CallContext(ctx, &resp, "eth_getBlockByHash", blockHash, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_getBlockByHash: %w", err)
 	} else if resp == nil {
 		return nil, ethereum.NotFound
 	}
-	ethBlock, err := p.Client.BlockByHash(context.Background(), blockHash)
+	ethBlock, err := p.Client.BlockByHash(ctx, blockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -125,8 +251,12 @@ func (p *DefaultProvider) GetBlockByHash(blockHash common.Hash) (*Block, error)
 }
 
 func (p *DefaultProvider) GetTransactionCount(address common.Address, blockNumber BlockNumber) (*big.Int, error) {
+	return p.GetTransactionCountContext(context.Background(), address, blockNumber)
+}
+
+func (p *DefaultProvider) GetTransactionCountContext(ctx context.Context, address common.Address, blockNumber BlockNumber) (*big.Int, error) {
 	var res string
-	err := p.c.Call(&res, "eth_getTransactionCount", address, blockNumber)
+	err := p.c.CallContext(ctx, &res, "eth_getTransactionCount", address, blockNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_getTransactionCount: %w", err)
 	}
@@ -138,8 +268,12 @@ func (p *DefaultProvider) GetTransactionCount(address common.Address, blockNumbe
 }
 
 func (p *DefaultProvider) GetTransactionReceipt(txHash common.Hash) (*TransactionReceipt, error) {
+	return p.GetTransactionReceiptContext(context.Background(), txHash)
+}
+
+func (p *DefaultProvider) GetTransactionReceiptContext(ctx context.Context, txHash common.Hash) (*TransactionReceipt, error) {
 	var resp *TransactionReceipt
-	err := p.c.Call(&resp, "eth_getTransactionReceipt", txHash)
+	err := p.c.CallContext(ctx, &resp, "eth_getTransactionReceipt", txHash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_getTransactionReceipt: %w", err)
 	} else if resp == nil {
@@ -149,8 +283,12 @@ func (p *DefaultProvider) GetTransactionReceipt(txHash common.Hash) (*Transactio
 }
 
 func (p *DefaultProvider) GetTransaction(txHash common.Hash) (*TransactionResponse, error) {
+	return p.GetTransactionContext(context.Background(), txHash)
+}
+
+func (p *DefaultProvider) GetTransactionContext(ctx context.Context, txHash common.Hash) (*TransactionResponse, error) {
 	var resp *TransactionResponse
-	err := p.c.Call(&resp, "eth_getTransactionByHash", txHash)
+	err := p.c.CallContext(ctx, &resp, "eth_getTransactionByHash", txHash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_getTransactionByHash: %w", err)
 	} else if resp == nil {
@@ -160,8 +298,12 @@ func (p *DefaultProvider) GetTransaction(txHash common.Hash) (*TransactionRespon
 }
 
 func (p *DefaultProvider) EstimateGas(tx *Transaction) (*big.Int, error) {
+	return p.EstimateGasContext(context.Background(), tx)
+}
+
+func (p *DefaultProvider) EstimateGasContext(ctx context.Context, tx *Transaction) (*big.Int, error) {
 	var res string
-	err := p.c.Call(&res, "eth_estimateGas", tx, BlockNumberLatest)
+	err := p.c.CallContext(ctx, &res, "eth_estimateGas", tx, BlockNumberLatest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_estimateGas: %w", err)
 	}
@@ -173,8 +315,12 @@ func (p *DefaultProvider) EstimateGas(tx *Transaction) (*big.Int, error) {
 }
 
 func (p *DefaultProvider) GetGasPrice() (*big.Int, error) {
+	return p.GetGasPriceContext(context.Background())
+}
+
+func (p *DefaultProvider) GetGasPriceContext(ctx context.Context) (*big.Int, error) {
 	var res string
-	err := p.c.Call(&res, "eth_gasPrice")
+	err := p.c.CallContext(ctx, &res, "eth_gasPrice")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query eth_gasPrice: %w", err)
 	}
@@ -186,8 +332,12 @@ func (p *DefaultProvider) GetGasPrice() (*big.Int, error) {
 }
 
 func (p *DefaultProvider) SendRawTransaction(tx []byte) (common.Hash, error) {
+	return p.SendRawTransactionContext(context.Background(), tx)
+}
+
+func (p *DefaultProvider) SendRawTransactionContext(ctx context.Context, tx []byte) (common.Hash, error) {
 	var res string
-	err := p.c.Call(&res, "eth_sendRawTransaction", hexutil.Encode(tx))
+	err := p.c.CallContext(ctx, &res, "eth_sendRawTransaction", hexutil.Encode(tx))
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("failed to call eth_sendRawTransaction: %w", err)
 	}
@@ -195,8 +345,12 @@ func (p *DefaultProvider) SendRawTransaction(tx []byte) (common.Hash, error) {
 }
 
 func (p *DefaultProvider) ZksGetMainContract() (common.Address, error) {
+	return p.ZksGetMainContractContext(context.Background())
+}
+
+func (p *DefaultProvider) ZksGetMainContractContext(ctx context.Context) (common.Address, error) {
 	var res string
-	err := p.c.Call(&res, "zks_getMainContract")
+	err := p.c.CallContext(ctx, &res, "zks_getMainContract")
 	if err != nil {
 		return common.Address{}, fmt.Errorf("failed to query zks_getMainContract: %w", err)
 	}
@@ -204,8 +358,12 @@ func (p *DefaultProvider) ZksGetMainContract() (common.Address, error) {
 }
 
 func (p *DefaultProvider) ZksL1ChainId() (*big.Int, error) {
+	return p.ZksL1ChainIdContext(context.Background())
+}
+
+func (p *DefaultProvider) ZksL1ChainIdContext(ctx context.Context) (*big.Int, error) {
 	var res string
-	err := p.c.Call(&res, "zks_L1ChainId")
+	err := p.c.CallContext(ctx, &res, "zks_L1ChainId")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_L1ChainId: %w", err)
 	}
@@ -217,8 +375,12 @@ func (p *DefaultProvider) ZksL1ChainId() (*big.Int, error) {
 }
 
 func (p *DefaultProvider) ZksL1BatchNumber() (*big.Int, error) {
+	return p.ZksL1BatchNumberContext(context.Background())
+}
+
+func (p *DefaultProvider) ZksL1BatchNumberContext(ctx context.Context) (*big.Int, error) {
 	var res string
-	err := p.c.Call(&res, "zks_L1BatchNumber")
+	err := p.c.CallContext(ctx, &res, "zks_L1BatchNumber")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_L1BatchNumber: %w", err)
 	}
@@ -230,8 +392,12 @@ func (p *DefaultProvider) ZksL1BatchNumber() (*big.Int, error) {
 }
 
 func (p *DefaultProvider) ZksGetConfirmedTokens(from uint32, limit uint8) ([]*Token, error) {
+	return p.ZksGetConfirmedTokensContext(context.Background(), from, limit)
+}
+
+func (p *DefaultProvider) ZksGetConfirmedTokensContext(ctx context.Context, from uint32, limit uint8) ([]*Token, error) {
 	res := make([]*Token, 0)
-	err := p.c.Call(&res, "zks_getConfirmedTokens", from, limit)
+	err := p.c.CallContext(ctx, &res, "zks_getConfirmedTokens", from, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_getConfirmedTokens: %w", err)
 	}
@@ -239,8 +405,12 @@ func (p *DefaultProvider) ZksGetConfirmedTokens(from uint32, limit uint8) ([]*To
 }
 
 func (p *DefaultProvider) ZksIsTokenLiquid(address common.Address) (bool, error) {
+	return p.ZksIsTokenLiquidContext(context.Background(), address)
+}
+
+func (p *DefaultProvider) ZksIsTokenLiquidContext(ctx context.Context, address common.Address) (bool, error) {
 	var res bool
-	err := p.c.Call(&res, "zks_isTokenLiquid", address)
+	err := p.c.CallContext(ctx, &res, "zks_isTokenLiquid", address)
 	if err != nil {
 		return false, fmt.Errorf("failed to query zks_isTokenLiquid: %w", err)
 	}
@@ -248,8 +418,12 @@ func (p *DefaultProvider) ZksIsTokenLiquid(address common.Address) (bool, error)
 }
 
 func (p *DefaultProvider) ZksGetTokenPrice(address common.Address) (*big.Float, error) {
+	return p.ZksGetTokenPriceContext(context.Background(), address)
+}
+
+func (p *DefaultProvider) ZksGetTokenPriceContext(ctx context.Context, address common.Address) (*big.Float, error) {
 	var res string
-	err := p.c.Call(&res, "zks_getTokenPrice", address)
+	err := p.c.CallContext(ctx, &res, "zks_getTokenPrice", address)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_getTokenPrice: %w", err)
 	}
@@ -261,8 +435,12 @@ func (p *DefaultProvider) ZksGetTokenPrice(address common.Address) (*big.Float,
 }
 
 func (p *DefaultProvider) ZksGetL2ToL1LogProof(txHash common.Hash, logIndex int) (*L2ToL1MessageProof, error) {
+	return p.ZksGetL2ToL1LogProofContext(context.Background(), txHash, logIndex)
+}
+
+func (p *DefaultProvider) ZksGetL2ToL1LogProofContext(ctx context.Context, txHash common.Hash, logIndex int) (*L2ToL1MessageProof, error) {
 	var resp *L2ToL1MessageProof
-	err := p.c.Call(&resp, "zks_getL2ToL1LogProof", txHash, logIndex)
+	err := p.c.CallContext(ctx, &resp, "zks_getL2ToL1LogProof", txHash, logIndex)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_getL2ToL1LogProof: %w", err)
 	} else if resp == nil {
@@ -272,8 +450,12 @@ func (p *DefaultProvider) ZksGetL2ToL1LogProof(txHash common.Hash, logIndex int)
 }
 
 func (p *DefaultProvider) ZksGetL2ToL1MsgProof(block uint32, sender common.Address, msg common.Hash) (*L2ToL1MessageProof, error) {
+	return p.ZksGetL2ToL1MsgProofContext(context.Background(), block, sender, msg)
+}
+
+func (p *DefaultProvider) ZksGetL2ToL1MsgProofContext(ctx context.Context, block uint32, sender common.Address, msg common.Hash) (*L2ToL1MessageProof, error) {
 	var resp *L2ToL1MessageProof
-	err := p.c.Call(&resp, "zks_getL2ToL1MsgProof", block, sender, msg)
+	err := p.c.CallContext(ctx, &resp, "zks_getL2ToL1MsgProof", block, sender, msg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_getL2ToL1MsgProof: %w", err)
 	} else if resp == nil {
@@ -283,8 +465,12 @@ func (p *DefaultProvider) ZksGetL2ToL1MsgProof(block uint32, sender common.Addre
 }
 
 func (p *DefaultProvider) ZksGetAllAccountBalances(address common.Address) (map[common.Address]*big.Int, error) {
+	return p.ZksGetAllAccountBalancesContext(context.Background(), address)
+}
+
+func (p *DefaultProvider) ZksGetAllAccountBalancesContext(ctx context.Context, address common.Address) (map[common.Address]*big.Int, error) {
 	res := make(map[common.Address]string)
-	err := p.c.Call(&res, "zks_getAllAccountBalances", address)
+	err := p.c.CallContext(ctx, &res, "zks_getAllAccountBalances", address)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_getAllAccountBalances: %w", err)
 	}
@@ -299,8 +485,12 @@ func (p *DefaultProvider) ZksGetAllAccountBalances(address common.Address) (map[
 }
 
 func (p *DefaultProvider) ZksGetBridgeContracts() (*BridgeContracts, error) {
+	return p.ZksGetBridgeContractsContext(context.Background())
+}
+
+func (p *DefaultProvider) ZksGetBridgeContractsContext(ctx context.Context) (*BridgeContracts, error) {
 	res := BridgeContracts{}
-	err := p.c.Call(&res, "zks_getBridgeContracts")
+	err := p.c.CallContext(ctx, &res, "zks_getBridgeContracts")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_getBridgeContracts: %w", err)
 	}
@@ -308,8 +498,12 @@ func (p *DefaultProvider) ZksGetBridgeContracts() (*BridgeContracts, error) {
 }
 
 func (p *DefaultProvider) ZksEstimateFee(tx *Transaction) (*Fee, error) {
+	return p.ZksEstimateFeeContext(context.Background(), tx)
+}
+
+func (p *DefaultProvider) ZksEstimateFeeContext(ctx context.Context, tx *Transaction) (*Fee, error) {
 	var res Fee
-	err := p.c.Call(&res, "zks_estimateFee", tx)
+	err := p.c.CallContext(ctx, &res, "zks_estimateFee", tx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_estimateFee: %w", err)
 	}
@@ -317,8 +511,12 @@ func (p *DefaultProvider) ZksEstimateFee(tx *Transaction) (*Fee, error) {
 }
 
 func (p *DefaultProvider) ZksGetTestnetPaymaster() (common.Address, error) {
+	return p.ZksGetTestnetPaymasterContext(context.Background())
+}
+
+func (p *DefaultProvider) ZksGetTestnetPaymasterContext(ctx context.Context) (common.Address, error) {
 	var res string
-	err := p.c.Call(&res, "zks_getTestnetPaymaster")
+	err := p.c.CallContext(ctx, &res, "zks_getTestnetPaymaster")
 	if err != nil {
 		return common.Address{}, fmt.Errorf("failed to query zks_estimateFee: %w", err)
 	}
@@ -326,8 +524,12 @@ func (p *DefaultProvider) ZksGetTestnetPaymaster() (common.Address, error) {
 }
 
 func (p *DefaultProvider) ZksGetBlockDetails(block uint32) (*BlockDetails, error) {
+	return p.ZksGetBlockDetailsContext(context.Background(), block)
+}
+
+func (p *DefaultProvider) ZksGetBlockDetailsContext(ctx context.Context, block uint32) (*BlockDetails, error) {
 	var resp *BlockDetails
-	err := p.c.Call(&resp, "zks_getBlockDetails", block)
+	err := p.c.CallContext(ctx, &resp, "zks_getBlockDetails", block)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query zks_getBlockDetails: %w", err)
 	} else if resp == nil {
@@ -340,7 +542,7 @@ func (p *DefaultProvider) WaitMined(ctx context.Context, txHash common.Hash) (*T
 	queryTicker := time.NewTicker(time.Second)
 	defer queryTicker.Stop()
 	for {
-		receipt, err := p.GetTransactionReceipt(txHash)
+		receipt, err := p.GetTransactionReceiptContext(ctx, txHash)
 		if err == nil && receipt.BlockNumber != nil {
 			return receipt, nil
 		}
@@ -385,11 +587,15 @@ func (p *DefaultProvider) WaitFinalized(ctx context.Context, txHash common.Hash)
 }
 
 func (p *DefaultProvider) GetLogs(q FilterQuery) ([]Log, error) {
+	return p.GetLogsContext(context.Background(), q)
+}
+
+func (p *DefaultProvider) GetLogsContext(ctx context.Context, q FilterQuery) ([]Log, error) {
 	var result []Log
 	arg, err := toFilterArg(q)
 	if err != nil {
 		return nil, err
 	}
-	err = p.c.Call(&result, "eth_getLogs", arg)
+	err = p.c.CallContext(ctx, &result, "eth_getLogs", arg)
 	return result, err
 }
@@ -0,0 +1,163 @@
+package zksync2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Batch accumulates typed RPC calls and dispatches them in a single
+// round-trip via rpc.Client.BatchCallContext, instead of the one-call-per-
+// request.Call() the rest of Provider does. Build it with
+// DefaultProvider.Batch(), queue calls on it, then Execute; each queued
+// call's Result() becomes available once Execute returns.
+type Batch struct {
+	c     *rpc.Client
+	elems []rpc.BatchElem
+	after []func()
+}
+
+// Batch always dispatches against the primary endpoint's raw client: a
+// Transport built from WithEndpoints/WithFallback can pick a different
+// backend per call, which BatchCallContext's single round-trip can't do.
+func (p *DefaultProvider) Batch() *Batch {
+	return &Batch{c: p.rpcClient}
+}
+
+func (b *Batch) queue(method string, result interface{}, args ...interface{}) int {
+	b.elems = append(b.elems, rpc.BatchElem{Method: method, Args: args, Result: result})
+	return len(b.elems) - 1
+}
+
+// Execute dispatches every queued call in a single batch request. It only
+// returns an error for transport-level failures; per-call failures surface
+// through that call's own Result() method instead.
+func (b *Batch) Execute(ctx context.Context) error {
+	if len(b.elems) == 0 {
+		return nil
+	}
+	if err := b.c.BatchCallContext(ctx, b.elems); err != nil {
+		return fmt.Errorf("failed to execute batch: %w", err)
+	}
+	for _, fn := range b.after {
+		fn()
+	}
+	return nil
+}
+
+// BigIntCall is a call queued on a Batch that resolves to a *big.Int.
+type BigIntCall struct {
+	raw string
+	val *big.Int
+	err error
+}
+
+func (c *BigIntCall) Result() (*big.Int, error) {
+	return c.val, c.err
+}
+
+func (b *Batch) addBigIntCall(method string, args ...interface{}) *BigIntCall {
+	call := &BigIntCall{}
+	idx := b.queue(method, &call.raw, args...)
+	b.after = append(b.after, func() {
+		if b.elems[idx].Error != nil {
+			call.err = fmt.Errorf("failed to query %s: %w", method, b.elems[idx].Error)
+			return
+		}
+		call.val, call.err = hexutil.DecodeBig(call.raw)
+		if call.err != nil {
+			call.err = fmt.Errorf("failed to decode response as big.Int: %w", call.err)
+		}
+	})
+	return call
+}
+
+func (b *Batch) GetBalance(address common.Address, blockNumber BlockNumber) *BigIntCall {
+	return b.addBigIntCall("eth_getBalance", address, blockNumber)
+}
+
+func (b *Batch) GetTransactionCount(address common.Address, blockNumber BlockNumber) *BigIntCall {
+	return b.addBigIntCall("eth_getTransactionCount", address, blockNumber)
+}
+
+func (b *Batch) GetGasPrice() *BigIntCall {
+	return b.addBigIntCall("eth_gasPrice")
+}
+
+// FeeCall is a call queued on a Batch that resolves to a *Fee.
+type FeeCall struct {
+	val Fee
+	err error
+}
+
+func (c *FeeCall) Result() (*Fee, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &c.val, nil
+}
+
+func (b *Batch) ZksEstimateFee(tx *Transaction) *FeeCall {
+	call := &FeeCall{}
+	idx := b.queue("zks_estimateFee", &call.val, tx)
+	b.after = append(b.after, func() {
+		if b.elems[idx].Error != nil {
+			call.err = fmt.Errorf("failed to query zks_estimateFee: %w", b.elems[idx].Error)
+		}
+	})
+	return call
+}
+
+// BlockHeaderCall is a call queued on a Batch that resolves to a
+// *BlockHeader. It only carries header-level fields: fetching a full Block
+// (as GetBlockByNumber does) needs its own ethclient round-trip per block
+// and so can't be folded into a single batch request.
+type BlockHeaderCall struct {
+	val *blockHeaderResp
+	err error
+}
+
+type blockHeaderResp struct {
+	Number           hexutil.Big  `json:"number"`
+	Hash             common.Hash  `json:"hash"`
+	L1BatchNumber    *hexutil.Big `json:"l1BatchNumber"`
+	L1BatchTimestamp *hexutil.Big `json:"l1BatchTimestamp"`
+}
+
+func (c *BlockHeaderCall) Result() (*BlockHeader, error) {
+	if c.err != nil {
+		return nil, c.err
+	} else if c.val == nil {
+		return nil, ethereum.NotFound
+	}
+	return &BlockHeader{
+		Number:           c.val.Number.ToInt(),
+		Hash:             c.val.Hash,
+		L1BatchNumber:    c.val.L1BatchNumber,
+		L1BatchTimestamp: c.val.L1BatchTimestamp,
+	}, nil
+}
+
+// BlockHeader is the lightweight, batch-friendly counterpart to Block.
+type BlockHeader struct {
+	Number           *big.Int
+	Hash             common.Hash
+	L1BatchNumber    *hexutil.Big
+	L1BatchTimestamp *hexutil.Big
+}
+
+func (b *Batch) GetBlockByNumber(blockNumber BlockNumber) *BlockHeaderCall {
+	call := &BlockHeaderCall{}
+	idx := b.queue("eth_getBlockByNumber", &call.val, blockNumber, false)
+	b.after = append(b.after, func() {
+		if b.elems[idx].Error != nil {
+			call.err = fmt.Errorf("failed to query eth_getBlockByNumber: %w", b.elems[idx].Error)
+		}
+	})
+	return call
+}
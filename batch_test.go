@@ -0,0 +1,77 @@
+package zksync2
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// runAfter simulates what Execute does once BatchCallContext has populated
+// b.elems, without needing a live *rpc.Client: run the queued after hooks.
+func runAfter(b *Batch) {
+	for _, fn := range b.after {
+		fn()
+	}
+}
+
+func TestBatchBigIntCallDecodesResult(t *testing.T) {
+	b := &Batch{}
+	call := b.GetGasPrice()
+	*(b.elems[0].Result.(*string)) = "0x2a"
+
+	runAfter(b)
+
+	val, err := call.Result()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if val.Cmp(big.NewInt(42)) != 0 {
+		t.Fatalf("expected 42, got %s", val)
+	}
+}
+
+func TestBatchBigIntCallPropagatesPerCallError(t *testing.T) {
+	b := &Batch{}
+	call := b.GetGasPrice()
+	wantErr := errors.New("rate limited")
+	b.elems[0].Error = wantErr
+
+	runAfter(b)
+
+	if _, err := call.Result(); !errors.Is(err, wantErr) {
+		t.Fatalf("expected wrapped %v, got %v", wantErr, err)
+	}
+}
+
+func TestBatchFeeCallPropagatesPerCallError(t *testing.T) {
+	b := &Batch{}
+	call := b.ZksEstimateFee(&Transaction{})
+	wantErr := errors.New("boom")
+	b.elems[0].Error = wantErr
+
+	runAfter(b)
+
+	if _, err := call.Result(); !errors.Is(err, wantErr) {
+		t.Fatalf("expected wrapped %v, got %v", wantErr, err)
+	}
+}
+
+func TestBatchBlockHeaderCallNotFoundWhenNil(t *testing.T) {
+	b := &Batch{}
+	call := b.GetBlockByNumber(BlockNumberLatest)
+
+	runAfter(b)
+
+	if _, err := call.Result(); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("expected ethereum.NotFound, got %v", err)
+	}
+}
+
+func TestBatchExecuteNoopOnEmptyBatch(t *testing.T) {
+	b := &Batch{}
+	if err := b.Execute(nil); err != nil {
+		t.Fatalf("expected no error for an empty batch, got %v", err)
+	}
+}
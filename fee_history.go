@@ -0,0 +1,59 @@
+package zksync2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// FeeHistoryResult mirrors eth_feeHistory's response: the base fee and gas
+// used ratio of each sampled block, plus (when rewardPercentiles was
+// non-empty) the priority fee at each requested percentile.
+type FeeHistoryResult struct {
+	OldestBlock   *big.Int
+	BaseFeePerGas []*big.Int
+	GasUsedRatio  []float64
+	Reward        [][]*big.Int
+}
+
+func (p *DefaultProvider) FeeHistory(blockCount uint64, newestBlock BlockNumber, rewardPercentiles []float64) (*FeeHistoryResult, error) {
+	return p.FeeHistoryContext(context.Background(), blockCount, newestBlock, rewardPercentiles)
+}
+
+func (p *DefaultProvider) FeeHistoryContext(ctx context.Context, blockCount uint64, newestBlock BlockNumber, rewardPercentiles []float64) (*FeeHistoryResult, error) {
+	type rawFeeHistory struct {
+		OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+		BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+		GasUsedRatio  []float64        `json:"gasUsedRatio"`
+		Reward        [][]*hexutil.Big `json:"reward"`
+	}
+	var res rawFeeHistory
+	err := p.c.CallContext(ctx, &res, "eth_feeHistory", hexutil.Uint64(blockCount), newestBlock, rewardPercentiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query eth_feeHistory: %w", err)
+	}
+
+	baseFees := make([]*big.Int, len(res.BaseFeePerGas))
+	for i, v := range res.BaseFeePerGas {
+		baseFees[i] = v.ToInt()
+	}
+	reward := make([][]*big.Int, len(res.Reward))
+	for i, block := range res.Reward {
+		reward[i] = make([]*big.Int, len(block))
+		for j, v := range block {
+			reward[i][j] = v.ToInt()
+		}
+	}
+	var oldest *big.Int
+	if res.OldestBlock != nil {
+		oldest = res.OldestBlock.ToInt()
+	}
+	return &FeeHistoryResult{
+		OldestBlock:   oldest,
+		BaseFeePerGas: baseFees,
+		GasUsedRatio:  res.GasUsedRatio,
+		Reward:        reward,
+	}, nil
+}
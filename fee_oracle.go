@@ -0,0 +1,210 @@
+package zksync2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// feeHistorySamples is how many recent blocks FeeOracle samples when
+// deriving a suggested fee.
+const feeHistorySamples = 20
+
+// feeHistoryRewardPercentile is the priority fee percentile FeeOracle
+// samples via eth_feeHistory when suggesting a priority fee.
+const feeHistoryRewardPercentile = 50
+
+// FeeOracle wraps GetGasPrice, ZksEstimateFee, and EstimateGas in a short
+// TTL cache, and derives EIP-1559-style fee suggestions from FeeHistory
+// samples, in the style of go-ethereum's gas price oracle. Boost biases
+// suggestions toward faster inclusion: 1.0 returns the raw sampled value,
+// 1.2 adds a 20% margin, and so on. It does not (yet) account for zkSync's
+// L2 pubdata fee component; ZksEstimateFee remains the source of truth for
+// that.
+type FeeOracle struct {
+	p     Provider
+	ttl   time.Duration
+	boost float64
+
+	mu       sync.Mutex
+	gasPrice cachedValue
+	gas      map[string]cachedValue
+	fees     map[string]cachedValue
+}
+
+// cachedValue never holds an error: a failed call simply isn't cached, so a
+// transient RPC failure can't get replayed to every caller for the rest of
+// the TTL.
+type cachedValue struct {
+	value interface{}
+	at    time.Time
+}
+
+// NewFeeOracle wraps p, caching responses for ttl and biasing fee
+// suggestions by boost (1.0 for no bias).
+func NewFeeOracle(p Provider, ttl time.Duration, boost float64) *FeeOracle {
+	return &FeeOracle{
+		p:     p,
+		ttl:   ttl,
+		boost: boost,
+		gas:   make(map[string]cachedValue),
+		fees:  make(map[string]cachedValue),
+	}
+}
+
+func (o *FeeOracle) GetGasPrice() (*big.Int, error) {
+	o.mu.Lock()
+	if cached, ok := o.freshLocked(o.gasPrice); ok {
+		o.mu.Unlock()
+		return cached.value.(*big.Int), nil
+	}
+	o.mu.Unlock()
+
+	price, err := o.p.GetGasPrice()
+	if err != nil {
+		return nil, err
+	}
+	o.mu.Lock()
+	o.gasPrice = cachedValue{value: price, at: time.Now()}
+	o.mu.Unlock()
+	return price, nil
+}
+
+func (o *FeeOracle) EstimateGas(tx *Transaction) (*big.Int, error) {
+	key, err := txCacheKey(tx)
+	if err != nil {
+		return o.p.EstimateGas(tx)
+	}
+
+	o.mu.Lock()
+	if cached, ok := o.freshLocked(o.gas[key]); ok {
+		o.mu.Unlock()
+		return cached.value.(*big.Int), nil
+	}
+	o.mu.Unlock()
+
+	gas, err := o.p.EstimateGas(tx)
+	if err != nil {
+		return nil, err
+	}
+	o.mu.Lock()
+	o.gas[key] = cachedValue{value: gas, at: time.Now()}
+	o.mu.Unlock()
+	return gas, nil
+}
+
+func (o *FeeOracle) ZksEstimateFee(tx *Transaction) (*Fee, error) {
+	key, err := txCacheKey(tx)
+	if err != nil {
+		return o.p.ZksEstimateFee(tx)
+	}
+
+	o.mu.Lock()
+	if cached, ok := o.freshLocked(o.fees[key]); ok {
+		o.mu.Unlock()
+		return cached.value.(*Fee), nil
+	}
+	o.mu.Unlock()
+
+	fee, err := o.p.ZksEstimateFee(tx)
+	if err != nil {
+		return nil, err
+	}
+	o.mu.Lock()
+	o.fees[key] = cachedValue{value: fee, at: time.Now()}
+	o.mu.Unlock()
+	return fee, nil
+}
+
+// freshLocked returns v unchanged along with true if it's within the
+// oracle's TTL; callers must hold o.mu.
+func (o *FeeOracle) freshLocked(v cachedValue) (cachedValue, bool) {
+	if v.at.IsZero() || time.Since(v.at) >= o.ttl {
+		return cachedValue{}, false
+	}
+	return v, true
+}
+
+func txCacheKey(tx *Transaction) (string, error) {
+	b, err := json.Marshal(tx)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive cache key for transaction: %w", err)
+	}
+	return string(b), nil
+}
+
+// sampleFeeHistory fetches both the base fee and reward percentile samples
+// SuggestMaxFeePerGas/SuggestMaxPriorityFeePerGas need in a single
+// eth_feeHistory round trip.
+func (o *FeeOracle) sampleFeeHistory() (*FeeHistoryResult, error) {
+	hist, err := o.p.FeeHistory(feeHistorySamples, BlockNumberLatest, []float64{feeHistoryRewardPercentile})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample fee history: %w", err)
+	}
+	return hist, nil
+}
+
+// rawPriorityFee averages hist's sampled reward percentile across blocks,
+// unboosted.
+func rawPriorityFee(hist *FeeHistoryResult) (*big.Int, error) {
+	if len(hist.Reward) == 0 {
+		return nil, errors.New("eth_feeHistory returned no reward samples")
+	}
+	sum := new(big.Int)
+	for _, block := range hist.Reward {
+		if len(block) > 0 {
+			sum.Add(sum, block[0])
+		}
+	}
+	return new(big.Int).Div(sum, big.NewInt(int64(len(hist.Reward)))), nil
+}
+
+// SuggestMaxPriorityFeePerGas samples the last feeHistorySamples blocks'
+// priority tips via FeeHistory and suggests a maxPriorityFeePerGas, with
+// FeeOracle's boost applied.
+func (o *FeeOracle) SuggestMaxPriorityFeePerGas() (*big.Int, error) {
+	hist, err := o.sampleFeeHistory()
+	if err != nil {
+		return nil, err
+	}
+	tip, err := rawPriorityFee(hist)
+	if err != nil {
+		return nil, err
+	}
+	return applyBoost(tip, o.boost), nil
+}
+
+// SuggestMaxFeePerGas samples the last feeHistorySamples blocks' base fee
+// via FeeHistory and suggests a maxFeePerGas covering a further doubling of
+// the base fee plus the suggested priority fee, with FeeOracle's boost
+// applied.
+func (o *FeeOracle) SuggestMaxFeePerGas() (*big.Int, error) {
+	hist, err := o.sampleFeeHistory()
+	if err != nil {
+		return nil, err
+	}
+	if len(hist.BaseFeePerGas) == 0 {
+		return nil, errors.New("eth_feeHistory returned no base fee samples")
+	}
+	latestBaseFee := hist.BaseFeePerGas[len(hist.BaseFeePerGas)-1]
+
+	tip, err := rawPriorityFee(hist)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFee := new(big.Int).Add(new(big.Int).Mul(latestBaseFee, big.NewInt(2)), tip)
+	return applyBoost(maxFee, o.boost), nil
+}
+
+func applyBoost(v *big.Int, boost float64) *big.Int {
+	if boost <= 0 {
+		return v
+	}
+	boosted := new(big.Float).Mul(new(big.Float).SetInt(v), big.NewFloat(boost))
+	result, _ := boosted.Int(nil)
+	return result
+}
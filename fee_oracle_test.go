@@ -0,0 +1,107 @@
+package zksync2
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestFeeOracleGasPriceCachesSuccess(t *testing.T) {
+	calls := 0
+	o := &FeeOracle{
+		ttl: time.Minute,
+		p: &stubGasPriceProvider{
+			fn: func() (*big.Int, error) {
+				calls++
+				return big.NewInt(42), nil
+			},
+		},
+		gas:  make(map[string]cachedValue),
+		fees: make(map[string]cachedValue),
+	}
+
+	for i := 0; i < 3; i++ {
+		price, err := o.GetGasPrice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if price.Cmp(big.NewInt(42)) != 0 {
+			t.Fatalf("expected 42, got %s", price)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single upstream call, got %d", calls)
+	}
+}
+
+func TestFeeOracleGasPriceDoesNotCacheErrors(t *testing.T) {
+	calls := 0
+	wantErr := errors.New("transient 429")
+	o := &FeeOracle{
+		ttl: time.Minute,
+		p: &stubGasPriceProvider{
+			fn: func() (*big.Int, error) {
+				calls++
+				if calls == 1 {
+					return nil, wantErr
+				}
+				return big.NewInt(7), nil
+			},
+		},
+		gas:  make(map[string]cachedValue),
+		fees: make(map[string]cachedValue),
+	}
+
+	if _, err := o.GetGasPrice(); !errors.Is(err, wantErr) {
+		t.Fatalf("expected transient error, got %v", err)
+	}
+
+	price, err := o.GetGasPrice()
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+	if price.Cmp(big.NewInt(7)) != 0 {
+		t.Fatalf("expected 7, got %s", price)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the failed call to not be cached, got %d calls", calls)
+	}
+}
+
+func TestFeeOracleGasPriceExpiresAfterTTL(t *testing.T) {
+	calls := 0
+	o := &FeeOracle{
+		ttl: time.Millisecond,
+		p: &stubGasPriceProvider{
+			fn: func() (*big.Int, error) {
+				calls++
+				return big.NewInt(1), nil
+			},
+		},
+		gas:  make(map[string]cachedValue),
+		fees: make(map[string]cachedValue),
+	}
+
+	if _, err := o.GetGasPrice(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := o.GetGasPrice(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the cache to expire after the TTL, got %d calls", calls)
+	}
+}
+
+// stubGasPriceProvider implements just enough of Provider for the FeeOracle
+// tests above; every other method panics if exercised.
+type stubGasPriceProvider struct {
+	Provider
+	fn func() (*big.Int, error)
+}
+
+func (s *stubGasPriceProvider) GetGasPrice() (*big.Int, error) {
+	return s.fn()
+}
@@ -0,0 +1,213 @@
+package zksync2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// reconnectDelay is how long a reconnectingSubscription waits before
+// redialing the WS endpoint after the underlying subscription drops.
+const reconnectDelay = time.Second
+
+// L1BatchCommit is emitted by SubscribeL1BatchCommits whenever an L1 batch
+// is committed on the main zkSync contract.
+type L1BatchCommit struct {
+	L1BatchNumber *hexutil.Big `json:"l1BatchNumber"`
+	CommitTxHash  common.Hash  `json:"commitTxHash"`
+	Timestamp     *hexutil.Big `json:"timestamp"`
+}
+
+// L2ToL1Message is emitted by SubscribeL2ToL1Messages for every L2->L1 log
+// produced by a transaction, ahead of it being provable via
+// ZksGetL2ToL1LogProof.
+type L2ToL1Message struct {
+	BlockNumber *hexutil.Big   `json:"blockNumber"`
+	TxHash      common.Hash    `json:"txHash"`
+	Sender      common.Address `json:"sender"`
+	Message     hexutil.Bytes  `json:"message"`
+}
+
+func (p *DefaultProvider) SubscribeNewHeads(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return p.subscribe(ctx, "eth", "newHeads", ch)
+}
+
+func (p *DefaultProvider) SubscribeLogs(ctx context.Context, q FilterQuery, ch chan<- Log) (ethereum.Subscription, error) {
+	arg, err := toFilterArg(q)
+	if err != nil {
+		return nil, err
+	}
+	return p.subscribe(ctx, "eth", "logs", ch, arg)
+}
+
+func (p *DefaultProvider) SubscribeNewPendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	return p.subscribe(ctx, "eth", "newPendingTransactions", ch)
+}
+
+func (p *DefaultProvider) SubscribeL1BatchCommits(ctx context.Context, ch chan<- *L1BatchCommit) (ethereum.Subscription, error) {
+	return p.subscribe(ctx, "zks", "l1BatchCommits", ch)
+}
+
+func (p *DefaultProvider) SubscribeL2ToL1Messages(ctx context.Context, ch chan<- *L2ToL1Message) (ethereum.Subscription, error) {
+	return p.subscribe(ctx, "zks", "l2ToL1Messages", ch)
+}
+
+// subscribe issues an <namespace>_subscribe call against the provider's
+// WS client and wraps the result in a reconnectingSubscription so callers
+// don't have to re-issue the subscription themselves every time the
+// underlying connection drops.
+func (p *DefaultProvider) subscribe(ctx context.Context, namespace, name string, ch interface{}, args ...interface{}) (ethereum.Subscription, error) {
+	sub, err := p.rpcClient.Subscribe(ctx, namespace, ch, append([]interface{}{name}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to %s_%s: %w", namespace, name, err)
+	}
+	return newReconnectingSubscription(p.rawUrl, namespace, name, ch, args, sub), nil
+}
+
+// reconnectingSubscription wraps an *rpc.ClientSubscription and transparently
+// redials the node and re-issues the subscription when the underlying
+// connection drops, so a consumer reading from ch never has to notice a
+// reconnect happened. Because of that, a receive on Err() here does NOT mean
+// the subscription is dead the way it would for a plain ethereum.Subscription
+// — it's a best-effort, non-blocking notice that a drop/reconnect occurred.
+// Err() only closes once Unsubscribe() is called.
+type reconnectingSubscription struct {
+	rawUrl    string
+	namespace string
+	name      string
+	ch        interface{}
+	args      []interface{}
+
+	mu  sync.Mutex
+	sub *rpc.ClientSubscription
+	// ownedClient is the *rpc.Client this subscription itself dialed on a
+	// reconnect, if any, and is responsible for closing. The very first
+	// client comes from the provider and is shared, so it's not tracked
+	// here and not closed by us.
+	ownedClient *rpc.Client
+	// closed is set once Unsubscribe has run, under mu. A reconnect() that
+	// is already in flight (blocked dialing/subscribing) when Unsubscribe
+	// fires checks this after acquiring mu and, if set, closes what it just
+	// dialed instead of installing it, so it isn't left running forever.
+	closed bool
+
+	errOnce sync.Once
+	errCh   chan error
+	quit    chan struct{}
+}
+
+func newReconnectingSubscription(rawUrl, namespace, name string, ch interface{}, args []interface{}, sub *rpc.ClientSubscription) *reconnectingSubscription {
+	s := &reconnectingSubscription{
+		rawUrl:    rawUrl,
+		namespace: namespace,
+		name:      name,
+		ch:        ch,
+		args:      args,
+		sub:       sub,
+		errCh:     make(chan error, 1),
+		quit:      make(chan struct{}),
+	}
+	go s.loop()
+	return s
+}
+
+func (s *reconnectingSubscription) loop() {
+	for {
+		s.mu.Lock()
+		sub := s.sub
+		s.mu.Unlock()
+
+		var dropErr error
+		select {
+		case err, ok := <-sub.Err():
+			// ok==false just means the subscription's own error channel
+			// closed (e.g. the remote end hung up) rather than sending an
+			// error; either way the subscription dropped and needs
+			// re-establishing below, so don't bail out here.
+			if ok {
+				dropErr = err
+			}
+		case <-s.quit:
+			close(s.errCh)
+			return
+		}
+		s.notifyDrop(dropErr)
+
+		for {
+			select {
+			case <-s.quit:
+				close(s.errCh)
+				return
+			case <-time.After(reconnectDelay):
+			}
+			if s.reconnect() {
+				break
+			}
+		}
+	}
+}
+
+// notifyDrop best-effort notifies a consumer selecting on Err() that the
+// subscription dropped and is being transparently re-established.
+func (s *reconnectingSubscription) notifyDrop(err error) {
+	select {
+	case s.errCh <- err:
+	default:
+	}
+}
+
+func (s *reconnectingSubscription) reconnect() bool {
+	client, err := rpc.Dial(s.rawUrl)
+	if err != nil {
+		return false
+	}
+	sub, err := client.Subscribe(context.Background(), s.namespace, s.ch, append([]interface{}{s.name}, s.args...)...)
+	if err != nil {
+		client.Close()
+		return false
+	}
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		// Unsubscribe already ran while we were dialing/subscribing above;
+		// nothing will ever read s.sub/s.ownedClient again, so close what we
+		// just dialed ourselves instead of leaking it.
+		sub.Unsubscribe()
+		client.Close()
+		return true
+	}
+	old := s.ownedClient
+	s.sub = sub
+	s.ownedClient = client
+	s.mu.Unlock()
+	if old != nil {
+		old.Close()
+	}
+	return true
+}
+
+func (s *reconnectingSubscription) Err() <-chan error {
+	return s.errCh
+}
+
+func (s *reconnectingSubscription) Unsubscribe() {
+	s.errOnce.Do(func() {
+		close(s.quit)
+	})
+	s.mu.Lock()
+	s.closed = true
+	sub := s.sub
+	owned := s.ownedClient
+	s.mu.Unlock()
+	sub.Unsubscribe()
+	if owned != nil {
+		owned.Close()
+	}
+}
@@ -0,0 +1,82 @@
+package zksync2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TraceConfig configures debug_traceCall/debug_traceTransaction/
+// debug_traceBlockByNumber. Tracer names the built-in tracer to use
+// (e.g. "callTracer", "prestateTracer"); leave it empty to fall back to the
+// node's default struct logger. TracerConfig is passed through verbatim as
+// the tracer's own config object.
+type TraceConfig struct {
+	Tracer       string          `json:"tracer,omitempty"`
+	TracerConfig json.RawMessage `json:"tracerConfig,omitempty"`
+	Timeout      string          `json:"timeout,omitempty"`
+}
+
+// TraceResult is the structured result of a debug_trace* call. Its exact
+// shape depends on the tracer used, so Result is left as raw JSON for
+// callers to unmarshal into the type matching their chosen tracer.
+type TraceResult struct {
+	TxHash common.Hash     `json:"txHash,omitempty"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error,omitempty"`
+}
+
+func (p *DefaultProvider) Call(tx *Transaction, blockNumber BlockNumber) ([]byte, error) {
+	return p.CallContext(context.Background(), tx, blockNumber)
+}
+
+func (p *DefaultProvider) CallContext(ctx context.Context, tx *Transaction, blockNumber BlockNumber) ([]byte, error) {
+	var res hexutil.Bytes
+	err := p.c.CallContext(ctx, &res, "eth_call", tx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query eth_call: %w", err)
+	}
+	return res, nil
+}
+
+func (p *DefaultProvider) TraceCall(tx *Transaction, blockNumber BlockNumber, config *TraceConfig) (*TraceResult, error) {
+	return p.TraceCallContext(context.Background(), tx, blockNumber, config)
+}
+
+func (p *DefaultProvider) TraceCallContext(ctx context.Context, tx *Transaction, blockNumber BlockNumber, config *TraceConfig) (*TraceResult, error) {
+	var res TraceResult
+	err := p.debugRPC().CallContext(ctx, &res.Result, "debug_traceCall", tx, blockNumber, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query debug_traceCall: %w", err)
+	}
+	return &res, nil
+}
+
+func (p *DefaultProvider) TraceTransaction(txHash common.Hash, config *TraceConfig) (*TraceResult, error) {
+	return p.TraceTransactionContext(context.Background(), txHash, config)
+}
+
+func (p *DefaultProvider) TraceTransactionContext(ctx context.Context, txHash common.Hash, config *TraceConfig) (*TraceResult, error) {
+	res := TraceResult{TxHash: txHash}
+	err := p.debugRPC().CallContext(ctx, &res.Result, "debug_traceTransaction", txHash, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query debug_traceTransaction: %w", err)
+	}
+	return &res, nil
+}
+
+func (p *DefaultProvider) TraceBlockByNumber(blockNumber BlockNumber, config *TraceConfig) ([]*TraceResult, error) {
+	return p.TraceBlockByNumberContext(context.Background(), blockNumber, config)
+}
+
+func (p *DefaultProvider) TraceBlockByNumberContext(ctx context.Context, blockNumber BlockNumber, config *TraceConfig) ([]*TraceResult, error) {
+	res := make([]*TraceResult, 0)
+	err := p.debugRPC().CallContext(ctx, &res, "debug_traceBlockByNumber", blockNumber, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query debug_traceBlockByNumber: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,244 @@
+package zksync2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// nonIdempotentMethods are write calls that must not be blindly resubmitted:
+// a dropped response doesn't mean the call didn't land, and retrying it can
+// rebroadcast a transaction (or otherwise repeat a side effect) that already
+// succeeded.
+var nonIdempotentMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+}
+
+// isRetryable reports whether err, returned by method, represents a
+// transient transport failure (timeout, connection reset, rate limiting)
+// worth retrying, as opposed to a permanent JSON-RPC error (bad params,
+// execution reverted) that retrying can't fix.
+func isRetryable(method string, err error) bool {
+	if nonIdempotentMethods[method] {
+		return false
+	}
+	// A well-formed JSON-RPC error response (rpc.Error, which carries an
+	// ErrorCode()) means the node understood the request and rejected it on
+	// its merits; retrying sends the exact same request and gets the exact
+	// same answer.
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	return true
+}
+
+// Transport is the subset of *rpc.Client used by Provider to make unary
+// JSON-RPC calls; *rpc.Client already satisfies this interface.
+// NewDefaultProvider wraps it according to its ProviderOptions, adding
+// round-robin across endpoints, a fallback endpoint, retries and metrics
+// around every p.c.CallContext(...) site. No option accepts a caller-supplied
+// Transport, so other layers such as rate limiting or request logging cannot
+// be plugged in.
+type Transport interface {
+	Call(result interface{}, method string, args ...interface{}) error
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// RetryPolicy configures exponential backoff retries for transient
+// transport failures, e.g. 429s from public RPC providers.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy makes up to 5 attempts in all (4 retries). The wait
+// before retry n is BaseDelay * 2^n, capped at MaxDelay, so the waits are
+// 400ms, 800ms, 1.6s and 3.2s.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+func (policy RetryPolicy) delay(attempt int) time.Duration {
+	d := policy.BaseDelay * time.Duration(math.Pow(2, float64(attempt)))
+	if d > policy.MaxDelay {
+		return policy.MaxDelay
+	}
+	return d
+}
+
+// retryTransport retries failed calls against the wrapped Transport
+// following an exponential backoff policy.
+type retryTransport struct {
+	next   Transport
+	policy RetryPolicy
+}
+
+func newRetryTransport(next Transport, policy RetryPolicy) *retryTransport {
+	return &retryTransport{next: next, policy: policy}
+}
+
+func (t *retryTransport) Call(result interface{}, method string, args ...interface{}) error {
+	return t.CallContext(context.Background(), result, method, args...)
+}
+
+func (t *retryTransport) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	var err error
+	for attempt := 0; attempt < t.policy.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(t.policy.delay(attempt)):
+			}
+		}
+		if err = t.next.CallContext(ctx, result, method, args...); err == nil {
+			return nil
+		}
+		if !isRetryable(method, err) {
+			return err
+		}
+	}
+	return fmt.Errorf("failed after %d attempts: %w", t.policy.MaxAttempts, err)
+}
+
+// metricsTransport records call counts and latencies, by method, for every
+// request made through the wrapped Transport.
+type metricsTransport struct {
+	next     Transport
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+func newMetricsTransport(next Transport, registerer prometheus.Registerer) *metricsTransport {
+	t := &metricsTransport{
+		next: next,
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "zksync2_rpc_call_duration_seconds",
+			Help: "Duration of zkSync JSON-RPC calls, by method.",
+		}, []string{"method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zksync2_rpc_call_errors_total",
+			Help: "Number of failed zkSync JSON-RPC calls, by method.",
+		}, []string{"method"}),
+	}
+	registerer.MustRegister(t.duration, t.errors)
+	return t
+}
+
+func (t *metricsTransport) Call(result interface{}, method string, args ...interface{}) error {
+	return t.CallContext(context.Background(), result, method, args...)
+}
+
+func (t *metricsTransport) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	start := time.Now()
+	err := t.next.CallContext(ctx, result, method, args...)
+	t.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		t.errors.WithLabelValues(method).Inc()
+	}
+	return err
+}
+
+// fallbackTransport retries a failed call against a secondary Transport,
+// for when the primary RPC endpoint is unavailable or rate-limited.
+type fallbackTransport struct {
+	primary   Transport
+	secondary Transport
+}
+
+func newFallbackTransport(primary, secondary Transport) *fallbackTransport {
+	return &fallbackTransport{primary: primary, secondary: secondary}
+}
+
+func (t *fallbackTransport) Call(result interface{}, method string, args ...interface{}) error {
+	return t.CallContext(context.Background(), result, method, args...)
+}
+
+func (t *fallbackTransport) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := t.primary.CallContext(ctx, result, method, args...); err == nil {
+		return nil
+	}
+	return t.secondary.CallContext(ctx, result, method, args...)
+}
+
+// roundRobinTransport spreads calls across multiple endpoints for basic
+// load balancing across a pool of RPC providers.
+type roundRobinTransport struct {
+	mu      sync.Mutex
+	next    int
+	clients []Transport
+}
+
+func newRoundRobinTransport(clients []Transport) *roundRobinTransport {
+	return &roundRobinTransport{clients: clients}
+}
+
+func (t *roundRobinTransport) pick() Transport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c := t.clients[t.next%len(t.clients)]
+	t.next++
+	return c
+}
+
+func (t *roundRobinTransport) Call(result interface{}, method string, args ...interface{}) error {
+	return t.pick().Call(result, method, args...)
+}
+
+func (t *roundRobinTransport) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return t.pick().CallContext(ctx, result, method, args...)
+}
+
+// ProviderOption configures NewDefaultProvider.
+type ProviderOption func(*providerConfig) error
+
+type providerConfig struct {
+	endpoints  []string
+	retry      *RetryPolicy
+	registerer prometheus.Registerer
+	fallback   string
+}
+
+// WithEndpoints load-balances calls round-robin across additional RPC
+// endpoints, alongside the primary rawUrl passed to NewDefaultProvider.
+func WithEndpoints(urls []string) ProviderOption {
+	return func(cfg *providerConfig) error {
+		cfg.endpoints = urls
+		return nil
+	}
+}
+
+// WithRetry retries failed calls using policy's exponential backoff.
+func WithRetry(policy RetryPolicy) ProviderOption {
+	return func(cfg *providerConfig) error {
+		cfg.retry = &policy
+		return nil
+	}
+}
+
+// WithMetrics records call counts and latencies on registerer.
+func WithMetrics(registerer prometheus.Registerer) ProviderOption {
+	return func(cfg *providerConfig) error {
+		cfg.registerer = registerer
+		return nil
+	}
+}
+
+// WithFallback routes calls to url whenever the primary endpoint (and any
+// WithEndpoints pool) fails.
+func WithFallback(url string) ProviderOption {
+	return func(cfg *providerConfig) error {
+		cfg.fallback = url
+		return nil
+	}
+}
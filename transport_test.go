@@ -0,0 +1,102 @@
+package zksync2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeRPCError implements rpc.Error, the interface retryTransport uses to
+// recognize a well-formed (and therefore non-retryable) JSON-RPC error
+// response.
+type fakeRPCError struct{ msg string }
+
+func (e *fakeRPCError) Error() string  { return e.msg }
+func (e *fakeRPCError) ErrorCode() int { return -32000 }
+
+type stubTransport struct {
+	calls int
+	fn    func(attempt int) error
+}
+
+func (s *stubTransport) Call(result interface{}, method string, args ...interface{}) error {
+	return s.CallContext(context.Background(), result, method, args...)
+}
+
+func (s *stubTransport) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	s.calls++
+	return s.fn(s.calls)
+}
+
+func TestRetryTransportRetriesTransientErrors(t *testing.T) {
+	transient := errors.New("connection reset")
+	stub := &stubTransport{fn: func(attempt int) error {
+		if attempt < 3 {
+			return transient
+		}
+		return nil
+	}}
+	transport := newRetryTransport(stub, RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+	if err := transport.CallContext(context.Background(), nil, "eth_call"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stub.calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", stub.calls)
+	}
+}
+
+func TestRetryTransportGivesUpAfterMaxAttempts(t *testing.T) {
+	transient := errors.New("connection reset")
+	stub := &stubTransport{fn: func(attempt int) error { return transient }}
+	transport := newRetryTransport(stub, RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+	if err := transport.CallContext(context.Background(), nil, "eth_call"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if stub.calls != 4 {
+		t.Fatalf("expected exactly MaxAttempts (4) attempts, got %d", stub.calls)
+	}
+}
+
+func TestRetryTransportDoesNotRetryPermanentRPCErrors(t *testing.T) {
+	permanent := &fakeRPCError{msg: "execution reverted"}
+	stub := &stubTransport{fn: func(attempt int) error { return permanent }}
+	transport := newRetryTransport(stub, RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+	if err := transport.CallContext(context.Background(), nil, "eth_call"); !errors.Is(err, permanent) {
+		t.Fatalf("expected the permanent error back unwrapped, got %v", err)
+	}
+	if stub.calls != 1 {
+		t.Fatalf("expected a single attempt for a permanent JSON-RPC error, got %d", stub.calls)
+	}
+}
+
+func TestRetryTransportDoesNotRetryNonIdempotentWrites(t *testing.T) {
+	transient := errors.New("connection reset")
+	stub := &stubTransport{fn: func(attempt int) error { return transient }}
+	transport := newRetryTransport(stub, RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+	if err := transport.CallContext(context.Background(), nil, "eth_sendRawTransaction"); !errors.Is(err, transient) {
+		t.Fatalf("expected the transient error back unwrapped, got %v", err)
+	}
+	if stub.calls != 1 {
+		t.Fatalf("expected a single attempt for eth_sendRawTransaction, got %d", stub.calls)
+	}
+}
+
+func TestRetryPolicyDelayBacksOffExponentiallyAndCaps(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 200 * time.Millisecond, MaxDelay: time.Second}
+
+	cases := map[int]time.Duration{
+		1: 400 * time.Millisecond,
+		2: 800 * time.Millisecond,
+		3: time.Second, // would be 1.6s uncapped, clamped to MaxDelay
+	}
+	for attempt, want := range cases {
+		if got := policy.delay(attempt); got != want {
+			t.Fatalf("delay(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}